@@ -20,13 +20,7 @@ import (
 //	evens := Filter(numbers, func(n int) bool { return n%2 == 0 })
 //	// evens == []int{2, 4, 6}
 func Filter[T any](slice []T, keep func(T) bool) []T {
-	out := make([]T, 0, len(slice))
-	for _, v := range slice {
-		if keep(v) {
-			out = append(out, v)
-		}
-	}
-	return out
+	return Collect(FilterSeq(From(slice), keep))
 }
 
 // Map returns a new slice containing the results of applying f to each
@@ -40,11 +34,7 @@ func Filter[T any](slice []T, keep func(T) bool) []T {
 //	})
 //	// strs == []string{"n=1", "n=2", "n=3"}
 func Map[T any, R any](slice []T, f func(T) R) []R {
-	out := make([]R, 0, len(slice))
-	for _, v := range slice {
-		out = append(out, f(v))
-	}
-	return out
+	return Collect(MapSeq(From(slice), f))
 }
 
 // Reduce applies f to each element of slice, accumulating the result, and
@@ -66,11 +56,7 @@ func Map[T any, R any](slice []T, f func(T) R) []R {
 //	})
 //	// joined == "go lang"
 func Reduce[T any, R any](slice []T, init R, f func(R, T) R) R {
-	acc := init
-	for _, v := range slice {
-		acc = f(acc, v)
-	}
-	return acc
+	return ReduceSeq(From(slice), init, f)
 }
 
 // LastIndex returns the index of the last occurrence of v in slice.
@@ -129,8 +115,7 @@ func Partition[T any](slice []T, pred func(T) bool) (matches, nonMatches []T) {
 func GroupBy[T any, K comparable](slice []T, keyFunc func(T) K) map[K][]T {
 	out := make(map[K][]T)
 
-	for _, v := range slice {
-		k := keyFunc(v)
+	for k, v := range GroupBySeq(From(slice), keyFunc) {
 		out[k] = append(out[k], v)
 	}
 	return out
@@ -161,17 +146,7 @@ func Flatten[T any](slices [][]T) []T {
 //	uniq := Unique(values)
 //	// uniq == []int{1, 2, 3, 4}
 func Unique[T comparable](slice []T) []T {
-	unique := make([]T, 0, len(slice))
-	seen := make(map[T]struct{})
-
-	for _, item := range slice {
-		if _, ok := seen[item]; !ok {
-			seen[item] = struct{}{}
-			unique = append(unique, item)
-		}
-	}
-
-	return unique
+	return Collect(UniqueSeq(From(slice)))
 }
 
 // UniqueBy returns a new slice containing only the unique elements of slice,
@@ -221,12 +196,7 @@ func UniqueBy[T any, K comparable](slice []T, keyFunc func(T) K) []T {
 //	chunks := Chunk(values, 2)
 //	// chunks == [][]int{{1, 2}, {3, 4}, {5}}
 func Chunk[T any](slice []T, size int) [][]T {
-	var chunks [][]T
-	for i := 0; i < len(slice); i += size {
-		end := min(i+size, len(slice))
-		chunks = append(chunks, slice[i:end])
-	}
-	return chunks
+	return Collect(ChunkSeq(From(slice), size))
 }
 
 // MinMax returns the minimum and maximum values of slice.
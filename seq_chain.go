@@ -0,0 +1,129 @@
+package seq
+
+import "slices"
+
+// Chain wraps a slice to allow chaining the functional helpers in this
+// package together, e.g. Of(xs).Filter(pred).Unique().Collect() instead of
+// Unique(Filter(xs, pred)). It is a thin wrapper: Collect returns the
+// underlying slice, and Filter, Unique, Partition and Chunk each have a
+// free-function equivalent elsewhere in this package.
+//
+// T is constrained to comparable so that Unique can be a method rather than
+// a free function; for non-comparable element types, drop back to the free
+// functions directly.
+type Chain[T comparable] struct {
+	slice []T
+}
+
+// Of wraps slice in a Chain so its helper methods can be chained together.
+//
+// Example:
+//
+//	out := Of([]int{1, 2, 1, 3, 2}).Unique().Collect()
+//	// out == []int{1, 2, 3}
+func Of[T comparable](slice []T) Chain[T] {
+	return Chain[T]{slice: slice}
+}
+
+// MapChain applies f to every element of c and returns the results as a
+// Chain[R]. It is a package-level function, rather than a method on
+// Chain[T] named Map, because Go does not allow methods to introduce new
+// type parameters, and a same-named package-level function would collide
+// with the slice-based Map above.
+//
+// Example:
+//
+//	out := MapChain(Of([]int{1, 2, 3}), func(n int) string { return fmt.Sprint(n) }).Collect()
+//	// out == []string{"1", "2", "3"}
+func MapChain[T comparable, R comparable](c Chain[T], f func(T) R) Chain[R] {
+	return Chain[R]{slice: Map(c.slice, f)}
+}
+
+// UniqueByChain returns a Chain containing only the unique elements of c,
+// where uniqueness is determined by the key returned from keyFunc. It is a
+// package-level function, rather than a method on Chain[T] named UniqueBy,
+// for the same reason as MapChain above: the key type K is a new type
+// parameter that a method can't introduce, and erasing it to any would lose
+// the compile-time comparable check the free UniqueBy already gives callers.
+//
+// Example:
+//
+//	type User struct{ Email string }
+//	out := UniqueByChain(Of(users), func(u User) string { return u.Email }).Collect()
+func UniqueByChain[T comparable, K comparable](c Chain[T], keyFunc func(T) K) Chain[T] {
+	return Chain[T]{slice: UniqueBy(c.slice, keyFunc)}
+}
+
+// Filter returns a Chain containing only the elements of c for which keep
+// returns true.
+func (c Chain[T]) Filter(keep func(T) bool) Chain[T] {
+	return Chain[T]{slice: Filter(c.slice, keep)}
+}
+
+// Unique returns a Chain containing only the unique elements of c, in order
+// of first occurrence.
+func (c Chain[T]) Unique() Chain[T] {
+	return Chain[T]{slice: Unique(c.slice)}
+}
+
+// Partition splits c into two Chains: matches, containing elements for
+// which pred returns true, and nonMatches, containing the rest.
+func (c Chain[T]) Partition(pred func(T) bool) (matches, nonMatches Chain[T]) {
+	m, nm := Partition(c.slice, pred)
+	return Chain[T]{slice: m}, Chain[T]{slice: nm}
+}
+
+// Chunk splits c into consecutive sub-chains of at most size elements. The
+// caller must ensure size > 0.
+func (c Chain[T]) Chunk(size int) []Chain[T] {
+	chunks := Chunk(c.slice, size)
+	out := make([]Chain[T], len(chunks))
+	for i, chunk := range chunks {
+		out[i] = Chain[T]{slice: chunk}
+	}
+	return out
+}
+
+// Sort returns a Chain containing the elements of c sorted using cmp. Like
+// every other method on Chain, it does not mutate the slice c was built
+// from.
+func (c Chain[T]) Sort(cmp func(a, b T) int) Chain[T] {
+	sorted := slices.Clone(c.slice)
+	slices.SortFunc(sorted, cmp)
+	return Chain[T]{slice: sorted}
+}
+
+// Reverse returns a Chain containing the elements of c in reverse order.
+// Like every other method on Chain, it does not mutate the slice c was
+// built from.
+func (c Chain[T]) Reverse() Chain[T] {
+	reversed := slices.Clone(c.slice)
+	slices.Reverse(reversed)
+	return Chain[T]{slice: reversed}
+}
+
+// Collect returns the underlying slice.
+func (c Chain[T]) Collect() []T {
+	return c.slice
+}
+
+// Len returns the number of elements in c.
+func (c Chain[T]) Len() int {
+	return len(c.slice)
+}
+
+// First returns the first element of c. If c is empty, ok is false.
+func (c Chain[T]) First() (v T, ok bool) {
+	if len(c.slice) == 0 {
+		return v, false
+	}
+	return c.slice[0], true
+}
+
+// Last returns the last element of c. If c is empty, ok is false.
+func (c Chain[T]) Last() (v T, ok bool) {
+	if len(c.slice) == 0 {
+		return v, false
+	}
+	return c.slice[len(c.slice)-1], true
+}
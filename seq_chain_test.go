@@ -0,0 +1,119 @@
+package seq
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestChainFilterUnique(t *testing.T) {
+	got := Of([]int{1, 2, 2, 3, 4, 4, 5}).
+		Filter(func(n int) bool { return n%2 == 0 }).
+		Unique().
+		Collect()
+	want := []int{2, 4}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("Chain.Filter().Unique().Collect() = %v, want %v", got, want)
+	}
+}
+
+func TestChainUniqueBy(t *testing.T) {
+	type user struct {
+		Name  string
+		Email string
+	}
+
+	in := []user{
+		{Name: "Alice", Email: "a@example.com"},
+		{Name: "Alice Dup", Email: "a@example.com"},
+		{Name: "Bob", Email: "b@example.com"},
+	}
+
+	got := UniqueByChain(Of(in), func(u user) string { return u.Email }).Collect()
+	if len(got) != 2 {
+		t.Fatalf("Chain.UniqueBy() len = %d, want 2", len(got))
+	}
+	if got[0].Name != "Alice" || got[1].Name != "Bob" {
+		t.Fatalf("Chain.UniqueBy() = %v, want Alice then Bob", got)
+	}
+}
+
+func TestChainPartition(t *testing.T) {
+	matches, nonMatches := Of([]int{1, 2, 3, 4, 5, 6}).Partition(func(n int) bool { return n%2 == 0 })
+
+	if !slices.Equal(matches.Collect(), []int{2, 4, 6}) {
+		t.Fatalf("Chain.Partition() matches = %v, want %v", matches.Collect(), []int{2, 4, 6})
+	}
+	if !slices.Equal(nonMatches.Collect(), []int{1, 3, 5}) {
+		t.Fatalf("Chain.Partition() nonMatches = %v, want %v", nonMatches.Collect(), []int{1, 3, 5})
+	}
+}
+
+func TestChainChunk(t *testing.T) {
+	chunks := Of([]int{1, 2, 3, 4, 5}).Chunk(2)
+	if len(chunks) != 3 {
+		t.Fatalf("Chain.Chunk() len = %d, want 3", len(chunks))
+	}
+	if !slices.Equal(chunks[2].Collect(), []int{5}) {
+		t.Fatalf("Chain.Chunk()[2] = %v, want %v", chunks[2].Collect(), []int{5})
+	}
+}
+
+func TestChainSortReverse(t *testing.T) {
+	got := Of([]int{3, 1, 2}).Sort(cmp.Compare[int]).Reverse().Collect()
+	want := []int{3, 2, 1}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("Chain.Sort().Reverse().Collect() = %v, want %v", got, want)
+	}
+}
+
+func TestChainSortReverseDoNotMutateOriginal(t *testing.T) {
+	in := []int{3, 1, 2}
+	original := slices.Clone(in)
+
+	_ = Of(in).Sort(cmp.Compare[int])
+	if !slices.Equal(in, original) {
+		t.Fatalf("Chain.Sort() mutated original slice: got %v, want %v", in, original)
+	}
+
+	_ = Of(in).Reverse()
+	if !slices.Equal(in, original) {
+		t.Fatalf("Chain.Reverse() mutated original slice: got %v, want %v", in, original)
+	}
+}
+
+func TestChainLenFirstLast(t *testing.T) {
+	c := Of([]int{1, 2, 3})
+	if c.Len() != 3 {
+		t.Fatalf("Chain.Len() = %d, want 3", c.Len())
+	}
+
+	first, ok := c.First()
+	if !ok || first != 1 {
+		t.Fatalf("Chain.First() = (%d, %v), want (1, true)", first, ok)
+	}
+
+	last, ok := c.Last()
+	if !ok || last != 3 {
+		t.Fatalf("Chain.Last() = (%d, %v), want (3, true)", last, ok)
+	}
+
+	empty := Of([]int{})
+	if _, ok := empty.First(); ok {
+		t.Fatalf("Chain.First() on empty = (_, true), want false")
+	}
+	if _, ok := empty.Last(); ok {
+		t.Fatalf("Chain.Last() on empty = (_, true), want false")
+	}
+}
+
+func TestMapChain(t *testing.T) {
+	got := MapChain(Of([]int{1, 2, 3}), func(n int) int { return n * 2 }).Collect()
+	want := []int{2, 4, 6}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("MapChain() = %v, want %v", got, want)
+	}
+}
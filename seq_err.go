@@ -0,0 +1,137 @@
+package seq
+
+import "fmt"
+
+// IndexedError records the index at which a fallible operation over a
+// slice failed, along with the underlying error.
+type IndexedError struct {
+	Index int
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *IndexedError) Error() string {
+	return fmt.Sprintf("at index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error, so errors.Is and errors.As see
+// through IndexedError.
+func (e *IndexedError) Unwrap() error {
+	return e.Err
+}
+
+// MapErr returns a new slice containing the results of applying f to each
+// element of slice, stopping at the first error. On failure, it returns the
+// results computed so far along with an *IndexedError identifying where f
+// failed.
+//
+// Example:
+//
+//	ids := []string{"1", "2", "3"}
+//	nums, err := MapErr(ids, strconv.Atoi)
+//	// nums == []int{1, 2, 3}, err == nil
+func MapErr[T any, R any](slice []T, f func(T) (R, error)) ([]R, error) {
+	out := make([]R, 0, len(slice))
+	for i, v := range slice {
+		r, err := f(v)
+		if err != nil {
+			return out, &IndexedError{Index: i, Err: err}
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// MapErrCollect runs f over every element of slice, even after an error,
+// and returns the results aligned with a parallel slice of errors (nil
+// where f succeeded). At an index where f failed, the result is whatever f
+// returned alongside its error. Use this instead of MapErr when callers
+// want to aggregate every failure rather than fail fast.
+//
+// Example:
+//
+//	ids := []string{"1", "x", "3"}
+//	nums, errs := MapErrCollect(ids, strconv.Atoi)
+//	// nums == []int{1, 0, 3}, errs[1] != nil
+func MapErrCollect[T any, R any](slice []T, f func(T) (R, error)) ([]R, []error) {
+	out := make([]R, len(slice))
+	errs := make([]error, len(slice))
+	for i, v := range slice {
+		out[i], errs[i] = f(v)
+	}
+	return out, errs
+}
+
+// FilterErr returns a new slice containing only the elements of slice for
+// which keep returns true, stopping at the first error. On failure, it
+// returns the elements kept so far along with an *IndexedError identifying
+// where keep failed.
+//
+// Example:
+//
+//	paths := []string{"a.txt", "b.txt"}
+//	existing, err := FilterErr(paths, func(p string) (bool, error) {
+//		_, err := os.Stat(p)
+//		if errors.Is(err, os.ErrNotExist) {
+//			return false, nil
+//		}
+//		return err == nil, err
+//	})
+func FilterErr[T any](slice []T, keep func(T) (bool, error)) ([]T, error) {
+	out := make([]T, 0, len(slice))
+	for i, v := range slice {
+		ok, err := keep(v)
+		if err != nil {
+			return out, &IndexedError{Index: i, Err: err}
+		}
+		if ok {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+// ReduceErr applies f to each element of slice, accumulating the result,
+// stopping at the first error. On failure, it returns the accumulator as of
+// the last successful step along with an *IndexedError identifying where f
+// failed.
+//
+// Example:
+//
+//	lines := []string{"1", "2", "3"}
+//	sum, err := ReduceErr(lines, 0, func(acc int, s string) (int, error) {
+//		n, err := strconv.Atoi(s)
+//		return acc + n, err
+//	})
+//	// sum == 6, err == nil
+func ReduceErr[T any, R any](slice []T, init R, f func(R, T) (R, error)) (R, error) {
+	acc := init
+	for i, v := range slice {
+		next, err := f(acc, v)
+		if err != nil {
+			return acc, &IndexedError{Index: i, Err: err}
+		}
+		acc = next
+	}
+	return acc, nil
+}
+
+// ForEachErr calls f for each element of slice, stopping at the first
+// error. On failure, it returns an *IndexedError identifying where f
+// failed.
+//
+// Example:
+//
+//	urls := []string{"http://a", "http://b"}
+//	err := ForEachErr(urls, func(u string) error {
+//		_, err := http.Get(u)
+//		return err
+//	})
+func ForEachErr[T any](slice []T, f func(T) error) error {
+	for i, v := range slice {
+		if err := f(v); err != nil {
+			return &IndexedError{Index: i, Err: err}
+		}
+	}
+	return nil
+}
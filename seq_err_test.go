@@ -0,0 +1,139 @@
+package seq
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestMapErr(t *testing.T) {
+	t.Run("all succeed", func(t *testing.T) {
+		in := []string{"1", "2", "3"}
+		got, err := MapErr(in, strconv.Atoi)
+		if err != nil {
+			t.Fatalf("MapErr() err = %v, want nil", err)
+		}
+		want := []int{1, 2, 3}
+		if !slices.Equal(got, want) {
+			t.Fatalf("MapErr() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("stops at first error", func(t *testing.T) {
+		in := []string{"1", "x", "3"}
+		got, err := MapErr(in, strconv.Atoi)
+		if err == nil {
+			t.Fatalf("MapErr() err = nil, want non-nil")
+		}
+
+		var idxErr *IndexedError
+		if !errors.As(err, &idxErr) {
+			t.Fatalf("MapErr() err = %v, want *IndexedError", err)
+		}
+		if idxErr.Index != 1 {
+			t.Fatalf("MapErr() err.Index = %d, want 1", idxErr.Index)
+		}
+
+		want := []int{1}
+		if !slices.Equal(got, want) {
+			t.Fatalf("MapErr() partial results = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestMapErrCollect(t *testing.T) {
+	in := []string{"1", "x", "3"}
+	got, errs := MapErrCollect(in, strconv.Atoi)
+
+	want := []int{1, 0, 3}
+	if !slices.Equal(got, want) {
+		t.Fatalf("MapErrCollect() results = %v, want %v", got, want)
+	}
+	if errs[0] != nil || errs[2] != nil {
+		t.Fatalf("MapErrCollect() errs = %v, want nil at indices 0 and 2", errs)
+	}
+	if errs[1] == nil {
+		t.Fatalf("MapErrCollect() errs[1] = nil, want non-nil")
+	}
+}
+
+func TestFilterErr(t *testing.T) {
+	in := []string{"1", "2", "x", "4"}
+	got, err := FilterErr(in, func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return false, err
+		}
+		return n%2 == 0, nil
+	})
+
+	if err == nil {
+		t.Fatalf("FilterErr() err = nil, want non-nil")
+	}
+	var idxErr *IndexedError
+	if !errors.As(err, &idxErr) || idxErr.Index != 2 {
+		t.Fatalf("FilterErr() err = %v, want *IndexedError at index 2", err)
+	}
+
+	want := []string{"2"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("FilterErr() partial results = %v, want %v", got, want)
+	}
+}
+
+func TestReduceErr(t *testing.T) {
+	t.Run("all succeed", func(t *testing.T) {
+		in := []string{"1", "2", "3"}
+		sum, err := ReduceErr(in, 0, func(acc int, s string) (int, error) {
+			n, err := strconv.Atoi(s)
+			return acc + n, err
+		})
+		if err != nil {
+			t.Fatalf("ReduceErr() err = %v, want nil", err)
+		}
+		if sum != 6 {
+			t.Fatalf("ReduceErr() = %d, want 6", sum)
+		}
+	})
+
+	t.Run("stops at first error", func(t *testing.T) {
+		in := []string{"1", "x", "3"}
+		sum, err := ReduceErr(in, 0, func(acc int, s string) (int, error) {
+			n, err := strconv.Atoi(s)
+			return acc + n, err
+		})
+		if err == nil {
+			t.Fatalf("ReduceErr() err = nil, want non-nil")
+		}
+		if sum != 1 {
+			t.Fatalf("ReduceErr() partial = %d, want 1", sum)
+		}
+	})
+}
+
+func TestForEachErr(t *testing.T) {
+	in := []string{"1", "2", "x", "4"}
+	var seen []int
+	err := ForEachErr(in, func(s string) error {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		seen = append(seen, n)
+		return nil
+	})
+
+	if err == nil {
+		t.Fatalf("ForEachErr() err = nil, want non-nil")
+	}
+	var idxErr *IndexedError
+	if !errors.As(err, &idxErr) || idxErr.Index != 2 {
+		t.Fatalf("ForEachErr() err = %v, want *IndexedError at index 2", err)
+	}
+
+	want := []int{1, 2}
+	if !slices.Equal(seen, want) {
+		t.Fatalf("ForEachErr() seen = %v, want %v", seen, want)
+	}
+}
@@ -2,6 +2,7 @@ package seq_test
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/banky/seq"
 )
@@ -126,6 +127,98 @@ func ExampleMinMaxFunc() {
 	// Output: {Bob 25} {Charlie 40}
 }
 
+func ExampleFilterSeq() {
+	numbers := []int{1, 2, 3, 4, 5, 6}
+	evens := seq.Collect(seq.FilterSeq(seq.From(numbers), func(n int) bool { return n%2 == 0 }))
+	fmt.Println(evens)
+	// Output: [2 4 6]
+}
+
+func ExampleMapSeq() {
+	numbers := []int{1, 2, 3}
+	strs := seq.Collect(seq.MapSeq(seq.From(numbers), func(n int) string { return fmt.Sprintf("n=%d", n) }))
+	fmt.Println(strs)
+	// Output: [n=1 n=2 n=3]
+}
+
+func ExampleReduceSeq() {
+	numbers := []int{1, 2, 3, 4}
+	sum := seq.ReduceSeq(seq.From(numbers), 0, func(acc, n int) int { return acc + n })
+	fmt.Println(sum)
+	// Output: 10
+}
+
+func ExampleChunkSeq() {
+	values := []int{1, 2, 3, 4, 5}
+	chunks := seq.Collect(seq.ChunkSeq(seq.From(values), 2))
+	fmt.Println(chunks)
+	// Output: [[1 2] [3 4] [5]]
+}
+
+func ExampleParallelMap() {
+	numbers := []int{1, 2, 3, 4}
+	squares := seq.ParallelMap(numbers, 2, func(n int) int { return n * n })
+	fmt.Println(squares)
+	// Output: [1 4 9 16]
+}
+
+func ExampleUnion() {
+	fmt.Println(seq.Union([]int{1, 2, 3}, []int{2, 3, 4}, []int{4, 5}))
+	// Output: [1 2 3 4 5]
+}
+
+func ExampleIntersection() {
+	fmt.Println(seq.Intersection([]int{1, 2, 3, 4}, []int{2, 3, 4, 5}, []int{2, 4}))
+	// Output: [2 4]
+}
+
+func ExampleDifference() {
+	fmt.Println(seq.Difference([]int{1, 2, 3, 4}, []int{2, 4}))
+	// Output: [1 3]
+}
+
+func ExampleSymmetricDifference() {
+	fmt.Println(seq.SymmetricDifference([]int{1, 2, 3}, []int{2, 3, 4}))
+	// Output: [1 4]
+}
+
+func ExampleOf() {
+	out := seq.Of([]int{1, 2, 2, 3, 4, 4, 5}).
+		Filter(func(n int) bool { return n%2 == 0 }).
+		Unique().
+		Collect()
+	fmt.Println(out)
+	// Output: [2 4]
+}
+
+func ExampleMapErr() {
+	ids := []string{"1", "2", "3"}
+	nums, err := seq.MapErr(ids, strconv.Atoi)
+	fmt.Println(nums, err)
+	// Output: [1 2 3] <nil>
+}
+
+func ExampleWindow() {
+	fmt.Println(seq.Window([]int{1, 2, 3, 4}, 2))
+	// Output: [[1 2] [2 3] [3 4]]
+}
+
+func ExampleZip() {
+	fmt.Println(seq.Zip([]int{1, 2, 3}, []string{"a", "b"}))
+	// Output: [{1 a} {2 b}]
+}
+
+func ExampleUnzip() {
+	as, bs := seq.Unzip([]seq.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}})
+	fmt.Println(as, bs)
+	// Output: [1 2] [a b]
+}
+
+func ExampleInterleave() {
+	fmt.Println(seq.Interleave([]int{1, 2, 3}, []int{4, 5}, []int{6}))
+	// Output: [1 4 6 2 5 3]
+}
+
 // helper â€” replaces cmp.Compare but avoids extra imports
 func compare(a, b int) int {
 	switch {
@@ -0,0 +1,160 @@
+package seq
+
+import "iter"
+
+// From returns an iter.Seq[T] that yields the elements of slice in order.
+// It does not copy slice, so mutating slice while ranging over the
+// returned sequence is not safe.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3}
+//	for n := range From(numbers) {
+//		fmt.Println(n)
+//	}
+func From[T any](slice []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range slice {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect drains seq into a new slice, in order.
+//
+// Example:
+//
+//	doubled := Collect(MapSeq(From([]int{1, 2, 3}), func(n int) int { return n * 2 }))
+//	// doubled == []int{2, 4, 6}
+func Collect[T any](seq iter.Seq[T]) []T {
+	var out []T
+	for v := range seq {
+		out = append(out, v)
+	}
+	return out
+}
+
+// FilterSeq returns an iter.Seq[T] that yields only the elements of seq for
+// which keep returns true. Unlike Filter, nothing is evaluated until the
+// result is ranged over, so a downstream short-circuit (e.g. breaking out of
+// the range early) stops seq and keep from running on the remaining
+// elements.
+//
+// Example:
+//
+//	evens := Collect(FilterSeq(From([]int{1, 2, 3, 4}), func(n int) bool { return n%2 == 0 }))
+//	// evens == []int{2, 4}
+func FilterSeq[T any](seq iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if keep(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq returns an iter.Seq[R] that yields the results of applying f to each
+// element of seq, lazily.
+//
+// Example:
+//
+//	strs := Collect(MapSeq(From([]int{1, 2, 3}), func(n int) string {
+//		return fmt.Sprintf("n=%d", n)
+//	}))
+//	// strs == []string{"n=1", "n=2", "n=3"}
+func MapSeq[T any, R any](seq iter.Seq[T], f func(T) R) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// ReduceSeq applies f to each element of seq, accumulating the result, and
+// returns the final accumulated value. The accumulator is initialized with
+// init.
+//
+// Example:
+//
+//	sum := ReduceSeq(From([]int{1, 2, 3, 4}), 0, func(acc, n int) int {
+//		return acc + n
+//	})
+//	// sum == 10
+func ReduceSeq[T any, R any](seq iter.Seq[T], init R, f func(R, T) R) R {
+	acc := init
+	for v := range seq {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// UniqueSeq returns an iter.Seq[T] that yields only the unique elements of
+// seq. The order of first occurrence is preserved.
+//
+// Example:
+//
+//	uniq := Collect(UniqueSeq(From([]int{1, 2, 1, 3, 2})))
+//	// uniq == []int{1, 2, 3}
+func UniqueSeq[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for v := range seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ChunkSeq returns an iter.Seq[[]T] that yields consecutive sub-slices of at
+// most size elements from seq. The final chunk may be smaller than size.
+// The caller must ensure size > 0.
+//
+// Example:
+//
+//	chunks := Collect(ChunkSeq(From([]int{1, 2, 3, 4, 5}), 2))
+//	// chunks == [][]int{{1, 2}, {3, 4}, {5}}
+func ChunkSeq[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// GroupBySeq returns an iter.Seq2[K, T] that yields each element of seq
+// paired with the key returned from keyFunc, lazily.
+//
+// Example:
+//
+//	for k, v := range GroupBySeq(From([]string{"a", "bb", "ccc"}), func(s string) int { return len(s) }) {
+//		fmt.Println(k, v)
+//	}
+func GroupBySeq[T any, K comparable](seq iter.Seq[T], keyFunc func(T) K) iter.Seq2[K, T] {
+	return func(yield func(K, T) bool) {
+		for v := range seq {
+			if !yield(keyFunc(v), v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package seq
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFromCollect(t *testing.T) {
+	in := []int{1, 2, 3}
+	got := Collect(From(in))
+	if !slices.Equal(got, in) {
+		t.Fatalf("Collect(From()) = %v, want %v", got, in)
+	}
+}
+
+func TestFilterSeq(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6}
+	got := Collect(FilterSeq(From(in), func(v int) bool { return v%2 == 0 }))
+	want := []int{2, 4, 6}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("FilterSeq() = %v, want %v", got, want)
+	}
+}
+
+func TestFilterSeqShortCircuits(t *testing.T) {
+	var seen []int
+	in := From([]int{1, 2, 3, 4, 5})
+	filtered := FilterSeq(in, func(v int) bool {
+		seen = append(seen, v)
+		return true
+	})
+
+	for v := range filtered {
+		if v == 3 {
+			break
+		}
+	}
+
+	want := []int{1, 2, 3}
+	if !slices.Equal(seen, want) {
+		t.Fatalf("FilterSeq() evaluated %v, want it to stop after %v", seen, want)
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	in := []int{1, 2, 3}
+	got := Collect(MapSeq(From(in), func(v int) int { return v * 2 }))
+	want := []int{2, 4, 6}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("MapSeq() = %v, want %v", got, want)
+	}
+}
+
+func TestReduceSeq(t *testing.T) {
+	in := []int{1, 2, 3, 4}
+	got := ReduceSeq(From(in), 0, func(acc, v int) int { return acc + v })
+	const want = 10
+
+	if got != want {
+		t.Fatalf("ReduceSeq() = %d, want %d", got, want)
+	}
+}
+
+func TestUniqueSeq(t *testing.T) {
+	in := []int{1, 2, 1, 3, 2, 4, 4}
+	got := Collect(UniqueSeq(From(in)))
+	want := []int{1, 2, 3, 4}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("UniqueSeq() = %v, want %v", got, want)
+	}
+}
+
+func TestChunkSeq(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	got := Collect(ChunkSeq(From(in), 2))
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+
+	if len(got) != len(want) {
+		t.Fatalf("ChunkSeq() len = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !slices.Equal(got[i], want[i]) {
+			t.Fatalf("ChunkSeq()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestGroupBySeq(t *testing.T) {
+	in := []string{"a", "bb", "ccc", "dd", "e"}
+	got := map[int][]string{}
+	for k, v := range GroupBySeq(From(in), func(s string) int { return len(s) }) {
+		got[k] = append(got[k], v)
+	}
+
+	if !slices.Equal(got[1], []string{"a", "e"}) {
+		t.Fatalf("GroupBySeq()[1] = %v, want %v", got[1], []string{"a", "e"})
+	}
+	if !slices.Equal(got[2], []string{"bb", "dd"}) {
+		t.Fatalf("GroupBySeq()[2] = %v, want %v", got[2], []string{"bb", "dd"})
+	}
+	if !slices.Equal(got[3], []string{"ccc"}) {
+		t.Fatalf("GroupBySeq()[3] = %v, want %v", got[3], []string{"ccc"})
+	}
+}
+
+func TestFusedPipeline(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	got := Collect(MapSeq(FilterSeq(From(in), func(v int) bool { return v%2 == 0 }), func(v int) int { return v * v }))
+	want := []int{4, 16, 36, 64}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("fused pipeline = %v, want %v", got, want)
+	}
+}
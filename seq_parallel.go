@@ -0,0 +1,139 @@
+package seq
+
+import (
+	"context"
+	"sync"
+)
+
+// ParallelMap returns a new slice containing the results of applying f to
+// each element of slice, using up to workers goroutines. The output
+// preserves the order of slice regardless of which goroutine finishes first.
+// The caller must ensure workers > 0.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4}
+//	squares := ParallelMap(numbers, 2, func(n int) int { return n * n })
+//	// squares == []int{1, 4, 9, 16}
+func ParallelMap[T any, R any](slice []T, workers int, f func(T) R) []R {
+	out := make([]R, len(slice))
+
+	work := make(chan int, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range work {
+				out[idx] = f(slice[idx])
+			}
+		}()
+	}
+
+	for i := range slice {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	return out
+}
+
+// ParallelFilter returns a new slice containing only the elements of slice
+// for which keep returns true, using up to workers goroutines to evaluate
+// keep. The relative order of the kept elements is preserved. The caller
+// must ensure workers > 0.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5, 6}
+//	evens := ParallelFilter(numbers, 2, func(n int) bool { return n%2 == 0 })
+//	// evens == []int{2, 4, 6}
+func ParallelFilter[T any](slice []T, workers int, keep func(T) bool) []T {
+	kept := ParallelMap(slice, workers, func(v T) bool { return keep(v) })
+
+	out := make([]T, 0, len(slice))
+	for i, v := range slice {
+		if kept[i] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// ParallelForEach calls f for each element of slice, using up to workers
+// goroutines. It returns once every call to f has completed. The caller
+// must ensure workers > 0.
+//
+// Example:
+//
+//	var mu sync.Mutex
+//	total := 0
+//	ParallelForEach([]int{1, 2, 3, 4}, 2, func(n int) {
+//		mu.Lock()
+//		total += n
+//		mu.Unlock()
+//	})
+//	// total == 10
+func ParallelForEach[T any](slice []T, workers int, f func(T)) {
+	ParallelMap(slice, workers, func(v T) struct{} {
+		f(v)
+		return struct{}{}
+	})
+}
+
+// ParallelMapErr is like ParallelMap, but f may fail. On the first non-nil
+// error, the shared context passed to f is canceled so in-flight calls can
+// stop early, and ParallelMapErr returns that error. The partial results
+// slice is still returned, with unset indices left at their zero value.
+// The caller must ensure workers > 0.
+//
+// Example:
+//
+//	ids := []string{"1", "2", "x", "4"}
+//	nums, err := ParallelMapErr(ids, 2, func(ctx context.Context, s string) (int, error) {
+//		return strconv.Atoi(s)
+//	})
+//	// err != nil, reporting the failure to parse "x"
+func ParallelMapErr[T any, R any](slice []T, workers int, f func(context.Context, T) (R, error)) ([]R, error) {
+	out := make([]R, len(slice))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		once     sync.Once
+		firstErr error
+	)
+
+	work := make(chan int, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range work {
+				v, err := f(ctx, slice[idx])
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				out[idx] = v
+			}
+		}()
+	}
+
+	for i := range slice {
+		select {
+		case work <- i:
+		case <-ctx.Done():
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	return out, firstErr
+}
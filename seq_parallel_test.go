@@ -0,0 +1,85 @@
+package seq
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestParallelMap(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	got := ParallelMap(in, 3, func(v int) int { return v * v })
+	want := []int{1, 4, 9, 16, 25}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("ParallelMap() = %v, want %v", got, want)
+	}
+}
+
+func TestParallelMapEmpty(t *testing.T) {
+	var in []int
+	got := ParallelMap(in, 4, func(v int) int { return v })
+	if len(got) != 0 {
+		t.Fatalf("ParallelMap() = %v, want empty slice", got)
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6}
+	got := ParallelFilter(in, 3, func(v int) bool { return v%2 == 0 })
+	want := []int{2, 4, 6}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("ParallelFilter() = %v, want %v", got, want)
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	in := []int{1, 2, 3, 4}
+
+	var mu sync.Mutex
+	total := 0
+	ParallelForEach(in, 2, func(v int) {
+		mu.Lock()
+		total += v
+		mu.Unlock()
+	})
+
+	const want = 10
+	if total != want {
+		t.Fatalf("ParallelForEach() total = %d, want %d", total, want)
+	}
+}
+
+func TestParallelMapErr(t *testing.T) {
+	t.Run("no errors", func(t *testing.T) {
+		in := []string{"1", "2", "3"}
+		got, err := ParallelMapErr(in, 2, func(ctx context.Context, s string) (int, error) {
+			return strconv.Atoi(s)
+		})
+		if err != nil {
+			t.Fatalf("ParallelMapErr() err = %v, want nil", err)
+		}
+		want := []int{1, 2, 3}
+		if !slices.Equal(got, want) {
+			t.Fatalf("ParallelMapErr() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("propagates first error", func(t *testing.T) {
+		in := []string{"1", "x", "3"}
+		_, err := ParallelMapErr(in, 1, func(ctx context.Context, s string) (int, error) {
+			return strconv.Atoi(s)
+		})
+		if err == nil {
+			t.Fatalf("ParallelMapErr() err = nil, want non-nil")
+		}
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) {
+			t.Fatalf("ParallelMapErr() err = %v, want *strconv.NumError", err)
+		}
+	})
+}
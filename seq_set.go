@@ -0,0 +1,199 @@
+package seq
+
+// Union returns the elements that appear in any of slices, in order of
+// first occurrence across slices, with duplicates removed.
+//
+// Example:
+//
+//	Union([]int{1, 2, 3}, []int{2, 3, 4}, []int{4, 5})
+//	// == []int{1, 2, 3, 4, 5}
+func Union[T comparable](slices ...[]T) []T {
+	var size int
+	for _, s := range slices {
+		size += len(s)
+	}
+
+	out := make([]T, 0, size)
+	seen := make(map[T]struct{}, size)
+	for _, s := range slices {
+		for _, v := range s {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// UnionBy is like Union, but uses keyFunc to determine equality for
+// elements that are not comparable. keyFunc comes first, rather than last
+// as in the rest of this package's *By functions (e.g. UniqueBy), because
+// Go requires the variadic slices parameter to come last.
+//
+// Example:
+//
+//	type User struct{ Email string }
+//	UnionBy(func(u User) string { return u.Email }, usersA, usersB)
+func UnionBy[T any, K comparable](keyFunc func(T) K, slices ...[]T) []T {
+	var size int
+	for _, s := range slices {
+		size += len(s)
+	}
+
+	out := make([]T, 0, size)
+	seen := make(map[K]struct{}, size)
+	for _, s := range slices {
+		for _, v := range s {
+			k := keyFunc(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Intersection returns the elements of a that also appear in every slice in
+// rest, in the order they first occur in a, with duplicates removed.
+//
+// Example:
+//
+//	Intersection([]int{1, 2, 3, 4}, []int{2, 3, 4, 5}, []int{2, 4})
+//	// == []int{2, 4}
+func Intersection[T comparable](a []T, rest ...[]T) []T {
+	sets := make([]map[T]struct{}, len(rest))
+	for i, s := range rest {
+		sets[i] = toSet(s)
+	}
+
+	out := make([]T, 0, len(a))
+	seen := make(map[T]struct{}, len(a))
+	for _, v := range a {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		if inAll(v, sets) {
+			seen[v] = struct{}{}
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// IntersectionBy is like Intersection, but uses keyFunc to determine
+// equality for elements that are not comparable. keyFunc comes first, for
+// the same reason as in UnionBy: the variadic rest parameter must come
+// last.
+func IntersectionBy[T any, K comparable](keyFunc func(T) K, a []T, rest ...[]T) []T {
+	sets := make([]map[K]struct{}, len(rest))
+	for i, s := range rest {
+		sets[i] = toSetBy(s, keyFunc)
+	}
+
+	out := make([]T, 0, len(a))
+	seen := make(map[K]struct{}, len(a))
+	for _, v := range a {
+		k := keyFunc(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		if inAll(k, sets) {
+			seen[k] = struct{}{}
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Difference returns the elements of a that do not appear in b, in the
+// order they first occur in a, with duplicates removed.
+//
+// Example:
+//
+//	Difference([]int{1, 2, 3, 4}, []int{2, 4})
+//	// == []int{1, 3}
+func Difference[T comparable](a, b []T) []T {
+	exclude := toSet(b)
+
+	out := make([]T, 0, len(a))
+	seen := make(map[T]struct{}, len(a))
+	for _, v := range a {
+		if _, ok := exclude[v]; ok {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
+
+// DifferenceBy is like Difference, but uses keyFunc to determine equality
+// for elements that are not comparable.
+func DifferenceBy[T any, K comparable](a, b []T, keyFunc func(T) K) []T {
+	exclude := toSetBy(b, keyFunc)
+
+	out := make([]T, 0, len(a))
+	seen := make(map[K]struct{}, len(a))
+	for _, v := range a {
+		k := keyFunc(v)
+		if _, ok := exclude[k]; ok {
+			continue
+		}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
+
+// SymmetricDifference returns the elements that appear in exactly one of a
+// or b: the elements of a not in b, followed by the elements of b not in a,
+// each in order of first occurrence with duplicates removed.
+//
+// Example:
+//
+//	SymmetricDifference([]int{1, 2, 3}, []int{2, 3, 4})
+//	// == []int{1, 4}
+func SymmetricDifference[T comparable](a, b []T) []T {
+	return append(Difference(a, b), Difference(b, a)...)
+}
+
+// SymmetricDifferenceBy is like SymmetricDifference, but uses keyFunc to
+// determine equality for elements that are not comparable.
+func SymmetricDifferenceBy[T any, K comparable](a, b []T, keyFunc func(T) K) []T {
+	return append(DifferenceBy(a, b, keyFunc), DifferenceBy(b, a, keyFunc)...)
+}
+
+func toSet[T comparable](slice []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(slice))
+	for _, v := range slice {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+func toSetBy[T any, K comparable](slice []T, keyFunc func(T) K) map[K]struct{} {
+	set := make(map[K]struct{}, len(slice))
+	for _, v := range slice {
+		set[keyFunc(v)] = struct{}{}
+	}
+	return set
+}
+
+func inAll[K comparable](k K, sets []map[K]struct{}) bool {
+	for _, set := range sets {
+		if _, ok := set[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package seq
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	got := Union([]int{1, 2, 3}, []int{2, 3, 4}, []int{4, 5})
+	want := []int{1, 2, 3, 4, 5}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("Union() = %v, want %v", got, want)
+	}
+}
+
+func TestUnionBy(t *testing.T) {
+	type user struct {
+		Name  string
+		Email string
+	}
+
+	a := []user{{Name: "Alice", Email: "a@example.com"}}
+	b := []user{{Name: "Alice Dup", Email: "a@example.com"}, {Name: "Bob", Email: "b@example.com"}}
+
+	got := UnionBy(func(u user) string { return u.Email }, a, b)
+	want := []user{
+		{Name: "Alice", Email: "a@example.com"},
+		{Name: "Bob", Email: "b@example.com"},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("UnionBy() len = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("UnionBy()[%d] = %#v, want %#v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	got := Intersection([]int{1, 2, 3, 4}, []int{2, 3, 4, 5}, []int{2, 4})
+	want := []int{2, 4}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("Intersection() = %v, want %v", got, want)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	got := Difference([]int{1, 2, 3, 4}, []int{2, 4})
+	want := []int{1, 3}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("Difference() = %v, want %v", got, want)
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	got := SymmetricDifference([]int{1, 2, 3}, []int{2, 3, 4})
+	want := []int{1, 4}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("SymmetricDifference() = %v, want %v", got, want)
+	}
+}
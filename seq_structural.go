@@ -0,0 +1,91 @@
+package seq
+
+// Pair holds two related values of possibly different types, as produced by
+// Zip and consumed by Unzip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Window returns all contiguous sub-slices of slice with length size, in
+// order. Each window is a fresh copy, so windows never alias each other or
+// slice, even though they overlap (matching Chunk's convention of copying
+// rather than aliasing). If size > len(slice), the result is empty. The
+// caller must ensure size > 0.
+//
+// Example:
+//
+//	Window([]int{1, 2, 3, 4}, 2)
+//	// == [][]int{{1, 2}, {2, 3}, {3, 4}}
+func Window[T any](slice []T, size int) [][]T {
+	if size > len(slice) {
+		return nil
+	}
+
+	out := make([][]T, 0, len(slice)-size+1)
+	for i := 0; i+size <= len(slice); i++ {
+		window := make([]T, size)
+		copy(window, slice[i:i+size])
+		out = append(out, window)
+	}
+	return out
+}
+
+// Zip pairs up the elements of a and b by index, stopping at the shorter of
+// the two slices.
+//
+// Example:
+//
+//	Zip([]int{1, 2, 3}, []string{"a", "b"})
+//	// == []Pair[int, string]{{1, "a"}, {2, "b"}}
+func Zip[A any, B any](a []A, b []B) []Pair[A, B] {
+	n := min(len(a), len(b))
+
+	out := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		out[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+	return out
+}
+
+// Unzip splits pairs into two slices holding the first and second elements
+// of each pair, respectively.
+//
+// Example:
+//
+//	Unzip([]Pair[int, string]{{1, "a"}, {2, "b"}})
+//	// == ([]int{1, 2}, []string{"a", "b"})
+func Unzip[A any, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i] = p.First
+		bs[i] = p.Second
+	}
+	return as, bs
+}
+
+// Interleave takes one element from each slice in round-robin order until
+// every slice is exhausted, skipping slices as they run out rather than
+// stopping at the shortest one.
+//
+// Example:
+//
+//	Interleave([]int{1, 2, 3}, []int{4, 5}, []int{6})
+//	// == []int{1, 4, 6, 2, 5, 3}
+func Interleave[T any](slices ...[]T) []T {
+	var total int
+	for _, s := range slices {
+		total += len(s)
+	}
+
+	out := make([]T, 0, total)
+	for i := 0; len(out) < total; i++ {
+		for _, s := range slices {
+			if i < len(s) {
+				out = append(out, s[i])
+			}
+		}
+	}
+	return out
+}
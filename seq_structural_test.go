@@ -0,0 +1,66 @@
+package seq
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWindow(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		in := []int{1, 2, 3, 4}
+		got := Window(in, 2)
+		want := [][]int{{1, 2}, {2, 3}, {3, 4}}
+
+		if len(got) != len(want) {
+			t.Fatalf("Window() len = %d, want %d", len(got), len(want))
+		}
+		for i := range want {
+			if !slices.Equal(got[i], want[i]) {
+				t.Fatalf("Window()[%d] = %v, want %v", i, got[i], want[i])
+			}
+		}
+	})
+
+	t.Run("size larger than slice", func(t *testing.T) {
+		in := []int{1, 2}
+		got := Window(in, 5)
+		if len(got) != 0 {
+			t.Fatalf("Window() = %v, want empty", got)
+		}
+	})
+}
+
+func TestZip(t *testing.T) {
+	got := Zip([]int{1, 2, 3}, []string{"a", "b"})
+	want := []Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}
+
+	if len(got) != len(want) {
+		t.Fatalf("Zip() len = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Zip()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	in := []Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}
+	as, bs := Unzip(in)
+
+	if !slices.Equal(as, []int{1, 2}) {
+		t.Fatalf("Unzip() as = %v, want %v", as, []int{1, 2})
+	}
+	if !slices.Equal(bs, []string{"a", "b"}) {
+		t.Fatalf("Unzip() bs = %v, want %v", bs, []string{"a", "b"})
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	got := Interleave([]int{1, 2, 3}, []int{4, 5}, []int{6})
+	want := []int{1, 4, 6, 2, 5, 3}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("Interleave() = %v, want %v", got, want)
+	}
+}